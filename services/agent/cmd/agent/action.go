@@ -0,0 +1,63 @@
+package main
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/sentinel/agent/internal/action"
+	"github.com/sentinel/agent/internal/config"
+	"github.com/sentinel/agent/internal/log"
+)
+
+var (
+	actionTarget string
+	actionArgs   []string
+)
+
+var actionCmd = &cobra.Command{
+	Use:   "action <name>",
+	Short: "Run a scoped node action locally for debugging",
+	Long: `Run a scoped node action (drain, cordon, restart-service, run-script, reboot)
+through the same executor code path the action server uses, without going
+over the network.`,
+	Args: cobra.ExactArgs(1),
+	RunE: runAction,
+}
+
+func init() {
+	actionCmd.Flags().StringVar(&actionTarget, "target", "", "action target (service name, script path, etc.)")
+	actionCmd.Flags().StringSliceVar(&actionArgs, "arg", nil, "additional argument, may be repeated")
+	rootCmd.AddCommand(actionCmd)
+}
+
+func runAction(cmd *cobra.Command, args []string) error {
+	cfg, err := config.Load(cfgFile)
+	if err != nil {
+		return fmt.Errorf("failed to load config: %w", err)
+	}
+
+	logger := log.NewLogger(cfg.Log.Level, cfg.Log.Format)
+
+	executor := action.NewExecutor(cfg.NodeID, cfg.Actions.Allowed, cfg.Actions.ScriptAllowlist, logger)
+
+	result, err := executor.Execute(context.Background(), action.Request{
+		Action: action.Name(args[0]),
+		Target: actionTarget,
+		Args:   actionArgs,
+	})
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf("exit_code=%d\n", result.ExitCode)
+	if result.Stdout != "" {
+		fmt.Printf("stdout:\n%s\n", result.Stdout)
+	}
+	if result.Stderr != "" {
+		fmt.Printf("stderr:\n%s\n", result.Stderr)
+	}
+
+	return nil
+}
@@ -6,15 +6,15 @@ import (
 	"os"
 	"os/signal"
 	"syscall"
-	"time"
 
 	"github.com/prometheus/client_golang/prometheus"
 	"github.com/spf13/cobra"
-	"go.uber.org/zap"
-	"go.uber.org/zap/zapcore"
 
+	"github.com/sentinel/agent/internal/action"
 	"github.com/sentinel/agent/internal/collector"
 	"github.com/sentinel/agent/internal/config"
+	"github.com/sentinel/agent/internal/exporter"
+	"github.com/sentinel/agent/internal/log"
 	"github.com/sentinel/agent/internal/metrics"
 )
 
@@ -51,18 +51,12 @@ func run(cmd *cobra.Command, args []string) error {
 	}
 
 	// Initialize logger
-	logger, err := initLogger(cfg.Log.Level, cfg.Log.Format)
-	if err != nil {
-		return fmt.Errorf("failed to initialize logger: %w", err)
-	}
-	defer func() {
-		_ = logger.Sync() // Ignore sync errors on shutdown
-	}()
+	logger := log.NewLogger(cfg.Log.Level, cfg.Log.Format)
 
 	logger.Info("starting Sentinel Agent",
-		zap.String("version", version),
-		zap.String("node_id", cfg.NodeID),
-		zap.Int("metrics_port", cfg.MetricsPort),
+		"version", version,
+		"node_id", cfg.NodeID,
+		"metrics_port", cfg.MetricsPort,
 	)
 
 	// Create context for graceful shutdown
@@ -76,21 +70,31 @@ func run(cmd *cobra.Command, args []string) error {
 	// Create Prometheus registry
 	registry := prometheus.NewRegistry()
 
-	// Create collector manager
-	collectorMgr := collector.NewManager(logger)
-
-	// Register system collector
-	systemCollector := collector.NewSystemCollector(cfg.NodeID, logger)
-	collectorMgr.Register(systemCollector)
+	// Build the node collector from every registered collector factory
+	// enabled by cfg.Collectors / cfg.Collector
+	nodeCollector, err := collector.NewNodeCollector(cfg, cfg.NodeID, logger)
+	if err != nil {
+		return fmt.Errorf("failed to build node collector: %w", err)
+	}
 
-	// Register GPU collector if enabled
+	// Register GPU or, on Jetson/Tegra SoCs where NVML isn't available, the
+	// tegrastats-based Jetson collector
 	if cfg.GPU.Enabled {
-		gpuCollector := collector.NewGPUCollector(cfg.NodeID, cfg.GPU.Enabled, logger)
-		collectorMgr.Register(gpuCollector)
+		if useJetsonCollector(cfg.GPU.Jetson) {
+			jetsonCollector := collector.NewJetsonCollector(cfg.NodeID, logger)
+			if err := jetsonCollector.Start(ctx); err != nil {
+				logger.Error("failed to start tegrastats, GPU metrics disabled", "error", err)
+			} else {
+				nodeCollector.Register(jetsonCollector)
+			}
+		} else {
+			gpuCollector := collector.NewGPUCollector(cfg.NodeID, cfg.GPU.Enabled, logger)
+			nodeCollector.Register(gpuCollector)
+		}
 	}
 
 	// Register collector manager with Prometheus
-	if err := registry.Register(collectorMgr); err != nil {
+	if err := registry.Register(nodeCollector); err != nil {
 		return fmt.Errorf("failed to register collector: %w", err)
 	}
 
@@ -100,62 +104,80 @@ func run(cmd *cobra.Command, args []string) error {
 	// Start metrics server in background
 	go func() {
 		if err := metricsServer.Start(ctx); err != nil {
-			logger.Error("metrics server failed", zap.Error(err))
+			logger.Error("metrics server failed", "error", err)
 			cancel()
 		}
 	}()
 
-	// Start collection loop
-	ticker := time.NewTicker(5 * time.Second)
-	defer ticker.Stop()
+	// Create and register the node-action executor
+	actionExecutor := action.NewExecutor(cfg.NodeID, cfg.Actions.Allowed, cfg.Actions.ScriptAllowlist, logger)
+	if err := registry.Register(actionExecutor); err != nil {
+		return fmt.Errorf("failed to register action executor: %w", err)
+	}
+
+	// Start the action server in background
+	actionServer := action.NewServer(cfg.Actions.Port, actionExecutor, cfg.TLS, cfg.ControlAPI, cfg.Actions.SigningKey, logger)
+	go func() {
+		if err := actionServer.Start(ctx); err != nil {
+			logger.Error("action server failed", "error", err)
+			cancel()
+		}
+	}()
+
+	// Start the push-based metrics exporter alongside the pull-based scrape
+	// server; it no-ops unless cfg.Export.Mode is "push" or "both"
+	metricsExporter, err := exporter.New(registry, cfg.Export, cfg.TLS, logger)
+	if err != nil {
+		return fmt.Errorf("failed to build metrics exporter: %w", err)
+	}
+	go func() {
+		if err := metricsExporter.Start(ctx); err != nil {
+			logger.Error("metrics exporter failed", "error", err)
+			cancel()
+		}
+	}()
 
 	logger.Info("agent started successfully")
 
-	// Main loop
+	// Main loop. Collectors now compute metrics lazily on each Prometheus
+	// scrape, so this just waits for shutdown.
 	for {
 		select {
-		case <-ticker.C:
-			if err := collectorMgr.CollectAll(ctx); err != nil {
-				logger.Error("collection failed", zap.Error(err))
-			}
-
 		case sig := <-sigCh:
-			logger.Info("received shutdown signal", zap.String("signal", sig.String()))
+			logger.Info("received shutdown signal", "signal", sig.String())
 			cancel()
-			// Shutdown metrics server
+			// Shutdown metrics and action servers
 			if err := metricsServer.Shutdown(); err != nil {
-				logger.Error("failed to shutdown metrics server", zap.Error(err))
+				logger.Error("failed to shutdown metrics server", "error", err)
+			}
+			if err := actionServer.Shutdown(); err != nil {
+				logger.Error("failed to shutdown action server", "error", err)
 			}
 			return nil
 
 		case <-ctx.Done():
 			logger.Info("shutting down agent")
-			// Shutdown metrics server
+			// Shutdown metrics and action servers
 			if err := metricsServer.Shutdown(); err != nil {
-				logger.Error("failed to shutdown metrics server", zap.Error(err))
+				logger.Error("failed to shutdown metrics server", "error", err)
+			}
+			if err := actionServer.Shutdown(); err != nil {
+				logger.Error("failed to shutdown action server", "error", err)
 			}
 			return nil
 		}
 	}
 }
 
-func initLogger(level, format string) (*zap.Logger, error) {
-	// Parse log level
-	var zapLevel zapcore.Level
-	if err := zapLevel.UnmarshalText([]byte(level)); err != nil {
-		zapLevel = zapcore.InfoLevel
+// useJetsonCollector decides between the NVML-based GPUCollector and the
+// tegrastats-based JetsonCollector based on cfg.GPU.Jetson.
+func useJetsonCollector(mode string) bool {
+	switch mode {
+	case "force":
+		return true
+	case "off":
+		return false
+	default: // "auto" or unset
+		return collector.IsJetson()
 	}
-
-	// Create config
-	var cfg zap.Config
-	if format == "json" {
-		cfg = zap.NewProductionConfig()
-	} else {
-		cfg = zap.NewDevelopmentConfig()
-	}
-
-	cfg.Level = zap.NewAtomicLevelAt(zapLevel)
-
-	// Build logger
-	return cfg.Build()
 }
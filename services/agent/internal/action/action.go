@@ -0,0 +1,34 @@
+// Package action implements the scoped node-action executor advertised in
+// the agent's help text: drain, cordon, restart-service, run-script, and
+// reboot, invoked either by the control plane over the action server or
+// locally via the `sentinel-agent action` CLI subcommand.
+package action
+
+import "time"
+
+// Name identifies a supported node action.
+type Name string
+
+// Supported action names.
+const (
+	Drain          Name = "drain"
+	Cordon         Name = "cordon"
+	RestartService Name = "restart-service"
+	RunScript      Name = "run-script"
+	Reboot         Name = "reboot"
+)
+
+// Request describes a single action invocation from the control plane.
+type Request struct {
+	Action  Name          `json:"action"`
+	Target  string        `json:"target,omitempty"` // service name, script path, etc.
+	Args    []string      `json:"args,omitempty"`
+	Timeout time.Duration `json:"timeout,omitempty"`
+}
+
+// Result is returned to the caller once an action finishes.
+type Result struct {
+	ExitCode int    `json:"exit_code"`
+	Stdout   string `json:"stdout"`
+	Stderr   string `json:"stderr"`
+}
@@ -0,0 +1,166 @@
+package action
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"os/exec"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+const defaultTimeout = 30 * time.Second
+
+// Executor runs scoped node actions on behalf of the control plane, gated by
+// an RBAC-style allow-list of action names loaded from config
+// (actions.allowed).
+type Executor struct {
+	logger          *slog.Logger
+	nodeID          string
+	allowed         map[Name]bool
+	scriptAllowlist map[string]bool
+
+	invokedTotal   *prometheus.CounterVec
+	succeededTotal *prometheus.CounterVec
+	failedTotal    *prometheus.CounterVec
+}
+
+// NewExecutor creates an Executor gated by allowed action names and a
+// run-script path allow-list.
+func NewExecutor(nodeID string, allowed []string, scriptAllowlist []string, logger *slog.Logger) *Executor {
+	allowedSet := make(map[Name]bool, len(allowed))
+	for _, a := range allowed {
+		allowedSet[Name(a)] = true
+	}
+
+	scriptSet := make(map[string]bool, len(scriptAllowlist))
+	for _, s := range scriptAllowlist {
+		scriptSet[s] = true
+	}
+
+	return &Executor{
+		logger:          logger,
+		nodeID:          nodeID,
+		allowed:         allowedSet,
+		scriptAllowlist: scriptSet,
+
+		invokedTotal: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: "sentinel_node",
+			Subsystem: "action",
+			Name:      "invoked_total",
+			Help:      "Total node actions invoked, by type",
+		}, []string{"node", "action"}),
+
+		succeededTotal: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: "sentinel_node",
+			Subsystem: "action",
+			Name:      "succeeded_total",
+			Help:      "Total node actions that completed successfully, by type",
+		}, []string{"node", "action"}),
+
+		failedTotal: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: "sentinel_node",
+			Subsystem: "action",
+			Name:      "failed_total",
+			Help:      "Total node actions that failed, by type",
+		}, []string{"node", "action"}),
+	}
+}
+
+// Describe implements prometheus.Collector
+func (e *Executor) Describe(ch chan<- *prometheus.Desc) {
+	e.invokedTotal.Describe(ch)
+	e.succeededTotal.Describe(ch)
+	e.failedTotal.Describe(ch)
+}
+
+// Collect implements prometheus.Collector
+func (e *Executor) Collect(ch chan<- prometheus.Metric) {
+	e.invokedTotal.Collect(ch)
+	e.succeededTotal.Collect(ch)
+	e.failedTotal.Collect(ch)
+}
+
+// Execute runs req, enforcing the allow-list and a bounded context timeout.
+// stdout/stderr are buffered in full and returned alongside the exit code
+// once the command exits; callers of a long-running action (e.g.
+// run-script) get nothing back until completion, not incremental output.
+func (e *Executor) Execute(ctx context.Context, req Request) (*Result, error) {
+	if !e.allowed[req.Action] {
+		return nil, fmt.Errorf("action %q is not in the allowed scope list", req.Action)
+	}
+
+	e.invokedTotal.WithLabelValues(e.nodeID, string(req.Action)).Inc()
+
+	timeout := req.Timeout
+	if timeout <= 0 {
+		timeout = defaultTimeout
+	}
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	cmd, err := e.command(ctx, req)
+	if err != nil {
+		e.failedTotal.WithLabelValues(e.nodeID, string(req.Action)).Inc()
+		return nil, err
+	}
+
+	var stdout, stderr bytes.Buffer
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+
+	result := &Result{}
+	runErr := cmd.Run()
+	result.Stdout = stdout.String()
+	result.Stderr = stderr.String()
+
+	var exitErr *exec.ExitError
+	switch {
+	case runErr == nil:
+		result.ExitCode = 0
+	case errors.As(runErr, &exitErr):
+		result.ExitCode = exitErr.ExitCode()
+	default:
+		e.failedTotal.WithLabelValues(e.nodeID, string(req.Action)).Inc()
+		return result, fmt.Errorf("action %q failed to start: %w", req.Action, runErr)
+	}
+
+	if result.ExitCode != 0 {
+		e.failedTotal.WithLabelValues(e.nodeID, string(req.Action)).Inc()
+	} else {
+		e.succeededTotal.WithLabelValues(e.nodeID, string(req.Action)).Inc()
+	}
+
+	return result, nil
+}
+
+func (e *Executor) command(ctx context.Context, req Request) (*exec.Cmd, error) {
+	switch req.Action {
+	case Drain:
+		return exec.CommandContext(ctx, "sentinel-drain", req.Target), nil
+
+	case Cordon:
+		return exec.CommandContext(ctx, "sentinel-cordon", req.Target), nil
+
+	case RestartService:
+		if req.Target == "" {
+			return nil, fmt.Errorf("restart-service requires a target service name")
+		}
+		return exec.CommandContext(ctx, "systemctl", "restart", req.Target), nil
+
+	case RunScript:
+		if !e.scriptAllowlist[req.Target] {
+			return nil, fmt.Errorf("script %q is not in the run-script allow-list", req.Target)
+		}
+		return exec.CommandContext(ctx, req.Target, req.Args...), nil
+
+	case Reboot:
+		return exec.CommandContext(ctx, "systemctl", "reboot"), nil
+
+	default:
+		return nil, fmt.Errorf("unknown action %q", req.Action)
+	}
+}
@@ -0,0 +1,94 @@
+package action
+
+import (
+	"context"
+	"log/slog"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/prometheus/client_golang/prometheus/testutil"
+)
+
+func testExecutor(allowed, scriptAllowlist []string) *Executor {
+	logger := slog.New(slog.NewTextHandler(os.Stderr, nil))
+	return NewExecutor("test-node", allowed, scriptAllowlist, logger)
+}
+
+func TestExecuteRejectsDisallowedAction(t *testing.T) {
+	e := testExecutor([]string{"drain"}, nil)
+
+	_, err := e.Execute(context.Background(), Request{Action: Cordon})
+	if err == nil {
+		t.Fatal("expected an error for an action not in the allowed scope list, got nil")
+	}
+}
+
+func TestExecuteRestartServiceRequiresTarget(t *testing.T) {
+	e := testExecutor([]string{string(RestartService)}, nil)
+
+	result, err := e.Execute(context.Background(), Request{
+		Action: RestartService,
+		Target: "",
+	})
+	if err == nil {
+		t.Fatal("expected an error for restart-service with no target, got nil")
+	}
+	if result != nil {
+		t.Fatalf("expected a nil result alongside the error, got %+v", result)
+	}
+}
+
+// TestExecuteRunsDrainAction proves an allowed, well-formed drain request
+// actually runs the underlying command and records success, using a fake
+// sentinel-drain binary placed on PATH in place of the real one.
+func TestExecuteRunsDrainAction(t *testing.T) {
+	dir := t.TempDir()
+	fakeBin := filepath.Join(dir, "sentinel-drain")
+	if err := os.WriteFile(fakeBin, []byte("#!/bin/sh\nexit 0\n"), 0o755); err != nil {
+		t.Fatalf("failed to write fake sentinel-drain binary: %v", err)
+	}
+	t.Setenv("PATH", dir+string(os.PathListSeparator)+os.Getenv("PATH"))
+
+	e := testExecutor([]string{string(Drain)}, nil)
+
+	result, err := e.Execute(context.Background(), Request{Action: Drain, Target: "node-1"})
+	if err != nil {
+		t.Fatalf("unexpected error running allow-listed drain action: %v", err)
+	}
+	if result.ExitCode != 0 {
+		t.Fatalf("expected exit code 0, got %d (stderr: %s)", result.ExitCode, result.Stderr)
+	}
+
+	if got := testutil.ToFloat64(e.succeededTotal.WithLabelValues("test-node", string(Drain))); got != 1 {
+		t.Fatalf("expected succeededTotal{action=drain} to be 1, got %v", got)
+	}
+}
+
+func TestExecuteRejectsScriptNotInAllowlist(t *testing.T) {
+	e := testExecutor([]string{string(RunScript)}, []string{"/opt/sentinel/allowed.sh"})
+
+	_, err := e.Execute(context.Background(), Request{
+		Action: RunScript,
+		Target: "/opt/sentinel/not-allowed.sh",
+	})
+	if err == nil {
+		t.Fatal("expected an error for a script not in the run-script allow-list, got nil")
+	}
+}
+
+func TestExecuteRunsAllowlistedScript(t *testing.T) {
+	e := testExecutor([]string{string(RunScript)}, []string{"/bin/echo"})
+
+	result, err := e.Execute(context.Background(), Request{
+		Action: RunScript,
+		Target: "/bin/echo",
+		Args:   []string{"hello"},
+	})
+	if err != nil {
+		t.Fatalf("unexpected error running allow-listed script: %v", err)
+	}
+	if result.ExitCode != 0 {
+		t.Fatalf("expected exit code 0, got %d (stderr: %s)", result.ExitCode, result.Stderr)
+	}
+}
@@ -0,0 +1,230 @@
+package action
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log/slog"
+	"net/http"
+	"net/url"
+	"os"
+	"time"
+
+	"github.com/sentinel/agent/internal/config"
+)
+
+// Server exposes the action executor over an authenticated HTTPS endpoint
+// that the control plane calls to invoke signed action requests. TLS
+// material is reused from cfg.TLS, with the configured CA enforcing mutual
+// TLS and pinning the expected client certificate identity to controlAPI
+// when set. Independent of TLS, every request body must carry a valid HMAC
+// signature under signingKey so auth doesn't rest on mTLS being enabled.
+type Server struct {
+	port       int
+	executor   *Executor
+	tlsCfg     config.TLSConfig
+	controlAPI string
+	signingKey string
+	logger     *slog.Logger
+	server     *http.Server
+}
+
+// NewServer creates a new action server. controlAPI is cfg.ControlAPI, used
+// to pin the expected mTLS client certificate identity; signingKey is
+// cfg.Actions.SigningKey, the shared secret used to verify request
+// signatures.
+func NewServer(port int, executor *Executor, tlsCfg config.TLSConfig, controlAPI, signingKey string, logger *slog.Logger) *Server {
+	return &Server{
+		port:       port,
+		executor:   executor,
+		tlsCfg:     tlsCfg,
+		controlAPI: controlAPI,
+		signingKey: signingKey,
+		logger:     logger,
+	}
+}
+
+// Start serves the action endpoint until ctx is canceled. Unlike a bare
+// http.Server, a TLS configuration or listen failure is returned to the
+// caller instead of only being logged, since this endpoint can reboot or run
+// scripts on the node and must fail loudly rather than silently go dark.
+func (s *Server) Start(ctx context.Context) error {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/v1/actions", s.handleAction)
+
+	s.server = &http.Server{
+		Addr:         fmt.Sprintf(":%d", s.port),
+		Handler:      mux,
+		ReadTimeout:  30 * time.Second,
+		WriteTimeout: 30 * time.Second,
+	}
+
+	if s.tlsCfg.Enabled {
+		tlsConfig, err := buildTLSConfig(s.tlsCfg, s.controlAPI)
+		if err != nil {
+			return fmt.Errorf("failed to build action server TLS config: %w", err)
+		}
+		s.server.TLSConfig = tlsConfig
+	}
+
+	s.logger.Info("starting action server", "port", s.port, "tls", s.tlsCfg.Enabled)
+
+	errCh := make(chan error, 1)
+	go func() {
+		var err error
+		if s.tlsCfg.Enabled {
+			err = s.server.ListenAndServeTLS(s.tlsCfg.Cert, s.tlsCfg.Key)
+		} else {
+			err = s.server.ListenAndServe()
+		}
+		if err != nil && err != http.ErrServerClosed {
+			errCh <- err
+		}
+	}()
+
+	select {
+	case err := <-errCh:
+		return fmt.Errorf("action server error: %w", err)
+	case <-ctx.Done():
+		return s.Shutdown()
+	}
+}
+
+// Shutdown gracefully shuts down the server.
+func (s *Server) Shutdown() error {
+	if s.server == nil {
+		return nil
+	}
+
+	s.logger.Info("shutting down action server")
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	return s.server.Shutdown(ctx)
+}
+
+func (s *Server) handleAction(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	if s.signingKey == "" {
+		s.logger.Error("rejecting action request: actions.signing_key is not configured")
+		http.Error(w, "action server is not configured to accept requests", http.StatusInternalServerError)
+		return
+	}
+
+	body, err := io.ReadAll(r.Body)
+	if err != nil {
+		http.Error(w, fmt.Sprintf("failed to read request body: %v", err), http.StatusBadRequest)
+		return
+	}
+
+	if !validSignature(s.signingKey, body, r.Header.Get("X-Sentinel-Signature")) {
+		s.logger.Warn("rejecting action request with invalid signature", "remote_addr", r.RemoteAddr)
+		http.Error(w, "invalid request signature", http.StatusUnauthorized)
+		return
+	}
+
+	var req Request
+	if err := json.Unmarshal(body, &req); err != nil {
+		http.Error(w, fmt.Sprintf("invalid request: %v", err), http.StatusBadRequest)
+		return
+	}
+
+	result, err := s.executor.Execute(r.Context(), req)
+	if err != nil {
+		s.logger.Warn("action failed", "action", string(req.Action), "error", err)
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(w).Encode(result); err != nil {
+		s.logger.Error("failed to write action response", "error", err)
+	}
+}
+
+// validSignature reports whether sigHex is the hex-encoded HMAC-SHA256 of
+// body keyed by secret, as set in the request's X-Sentinel-Signature header.
+func validSignature(secret string, body []byte, sigHex string) bool {
+	if sigHex == "" {
+		return false
+	}
+
+	sig, err := hex.DecodeString(sigHex)
+	if err != nil {
+		return false
+	}
+
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write(body)
+	return hmac.Equal(sig, mac.Sum(nil))
+}
+
+// buildTLSConfig loads the agent's TLS certificate and, when a CA is
+// configured, requires and verifies a client certificate from the caller
+// (mTLS) so only the control plane can invoke actions. When controlAPI is
+// set, it additionally pins the expected client certificate's common name to
+// controlAPI's host, so a certificate merely signed by the CA isn't enough.
+func buildTLSConfig(cfg config.TLSConfig, controlAPI string) (*tls.Config, error) {
+	cert, err := tls.LoadX509KeyPair(cfg.Cert, cfg.Key)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load TLS certificate: %w", err)
+	}
+
+	tlsConfig := &tls.Config{
+		Certificates: []tls.Certificate{cert},
+		MinVersion:   tls.VersionTLS12,
+	}
+
+	if cfg.CA != "" {
+		caCert, err := os.ReadFile(cfg.CA)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read CA certificate: %w", err)
+		}
+
+		pool := x509.NewCertPool()
+		if !pool.AppendCertsFromPEM(caCert) {
+			return nil, fmt.Errorf("failed to parse CA certificate")
+		}
+
+		tlsConfig.ClientCAs = pool
+		tlsConfig.ClientAuth = tls.RequireAndVerifyClientCert
+
+		if expectedCN := clientCommonName(controlAPI); expectedCN != "" {
+			tlsConfig.VerifyPeerCertificate = func(_ [][]byte, verifiedChains [][]*x509.Certificate) error {
+				for _, chain := range verifiedChains {
+					if len(chain) > 0 && chain[0].Subject.CommonName == expectedCN {
+						return nil
+					}
+				}
+				return fmt.Errorf("client certificate common name does not match control API %q", expectedCN)
+			}
+		}
+	}
+
+	return tlsConfig, nil
+}
+
+// clientCommonName extracts the expected client certificate common name from
+// controlAPI (a URL like "https://control.example.com:8000"), returning ""
+// if controlAPI is unset or unparsable.
+func clientCommonName(controlAPI string) string {
+	if controlAPI == "" {
+		return ""
+	}
+	u, err := url.Parse(controlAPI)
+	if err != nil {
+		return ""
+	}
+	return u.Hostname()
+}
@@ -1,40 +1,53 @@
 package collector
 
 import (
-	"context"
+	"log/slog"
 	"sync"
+	"time"
 
 	"github.com/prometheus/client_golang/prometheus"
-	"go.uber.org/zap"
 )
 
-// Collector interface for metric collection
+// Collector interface for metric collection. Values are computed lazily
+// inside CollectMetrics on every Prometheus scrape rather than cached by a
+// background collection loop.
 type Collector interface {
 	// Name returns the collector name
 	Name() string
 
-	// Collect gathers metrics
-	Collect(ctx context.Context) error
-
 	// Describe sends metric descriptors to the channel
 	Describe(ch chan<- *prometheus.Desc)
 
-	// Collect sends metrics to the channel
+	// CollectMetrics sends metrics to the channel
 	CollectMetrics(ch chan<- prometheus.Metric)
 }
 
 // Manager manages all collectors
 type Manager struct {
 	collectors []Collector
-	logger     *zap.Logger
+	logger     *slog.Logger
 	mu         sync.RWMutex
+
+	scrapeDuration *prometheus.Desc
+	scrapeSuccess  *prometheus.Desc
 }
 
 // NewManager creates a new collector manager
-func NewManager(logger *zap.Logger) *Manager {
+func NewManager(logger *slog.Logger) *Manager {
 	return &Manager{
 		collectors: make([]Collector, 0),
 		logger:     logger,
+
+		scrapeDuration: prometheus.NewDesc(
+			"sentinel_scrape_collector_duration_seconds",
+			"Time each collector took to scrape, in seconds",
+			[]string{"collector"}, nil,
+		),
+		scrapeSuccess: prometheus.NewDesc(
+			"sentinel_scrape_collector_success",
+			"1 if the collector scrape completed without panicking, 0 if it panicked; errors a collector logs and recovers from are not counted",
+			[]string{"collector"}, nil,
+		),
 	}
 }
 
@@ -44,40 +57,7 @@ func (m *Manager) Register(collector Collector) {
 	defer m.mu.Unlock()
 
 	m.collectors = append(m.collectors, collector)
-	m.logger.Info("registered collector", zap.String("name", collector.Name()))
-}
-
-// CollectAll runs all collectors
-func (m *Manager) CollectAll(ctx context.Context) error {
-	m.mu.RLock()
-	collectors := m.collectors
-	m.mu.RUnlock()
-
-	var wg sync.WaitGroup
-	errCh := make(chan error, len(collectors))
-
-	for _, c := range collectors {
-		wg.Add(1)
-		go func(collector Collector) {
-			defer wg.Done()
-			if err := collector.Collect(ctx); err != nil {
-				m.logger.Error("collector failed",
-					zap.String("name", collector.Name()),
-					zap.Error(err))
-				errCh <- err
-			}
-		}(c)
-	}
-
-	wg.Wait()
-	close(errCh)
-
-	// Return first error if any
-	for err := range errCh {
-		return err
-	}
-
-	return nil
+	m.logger.Info("registered collector", "name", collector.Name())
 }
 
 // Describe implements prometheus.Collector
@@ -88,14 +68,47 @@ func (m *Manager) Describe(ch chan<- *prometheus.Desc) {
 	for _, c := range m.collectors {
 		c.Describe(ch)
 	}
+	ch <- m.scrapeDuration
+	ch <- m.scrapeSuccess
 }
 
-// Collect implements prometheus.Collector
+// Collect implements prometheus.Collector, timing each collector's scrape
+// and emitting per-collector duration and success metrics alongside its
+// regular metrics.
 func (m *Manager) Collect(ch chan<- prometheus.Metric) {
 	m.mu.RLock()
 	defer m.mu.RUnlock()
 
 	for _, c := range m.collectors {
-		c.CollectMetrics(ch)
+		m.collectOne(c, ch)
 	}
 }
+
+func (m *Manager) collectOne(c Collector, ch chan<- prometheus.Metric) {
+	start := time.Now()
+	success := 1.0
+	errCount := 0
+
+	func() {
+		defer func() {
+			if r := recover(); r != nil {
+				m.logger.Error("collector panicked",
+					"name", c.Name(),
+					"panic", r)
+				success = 0
+				errCount++
+			}
+		}()
+		c.CollectMetrics(ch)
+	}()
+
+	duration := time.Since(start)
+	ch <- prometheus.MustNewConstMetric(m.scrapeDuration, prometheus.GaugeValue, duration.Seconds(), c.Name())
+	ch <- prometheus.MustNewConstMetric(m.scrapeSuccess, prometheus.GaugeValue, success, c.Name())
+
+	m.logger.Debug("collector scraped",
+		"collector", c.Name(),
+		"duration_ms", duration.Milliseconds(),
+		"errors", errCount,
+	)
+}
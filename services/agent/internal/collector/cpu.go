@@ -0,0 +1,68 @@
+package collector
+
+import (
+	"log/slog"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/shirou/gopsutil/v3/cpu"
+)
+
+func init() {
+	registerCollector("cpu", true, newCPUCollector)
+}
+
+// CPUCollector collects per-CPU time-in-mode metrics.
+type CPUCollector struct {
+	logger *slog.Logger
+	nodeID string
+
+	secondsTotal *prometheus.Desc
+}
+
+func newCPUCollector(nodeID string, logger *slog.Logger) Collector {
+	return &CPUCollector{
+		logger: logger,
+		nodeID: nodeID,
+
+		secondsTotal: prometheus.NewDesc(
+			"sentinel_node_cpu_seconds_total",
+			"Cumulative CPU time in seconds by mode",
+			[]string{"node", "cpu", "mode"}, nil,
+		),
+	}
+}
+
+// Name returns the collector name
+func (c *CPUCollector) Name() string {
+	return "cpu"
+}
+
+// Describe implements prometheus.Collector
+func (c *CPUCollector) Describe(ch chan<- *prometheus.Desc) {
+	ch <- c.secondsTotal
+}
+
+// CollectMetrics implements prometheus.Collector
+func (c *CPUCollector) CollectMetrics(ch chan<- prometheus.Metric) {
+	times, err := cpu.Times(true)
+	if err != nil {
+		c.logger.Warn("failed to collect CPU metrics", "error", err)
+		return
+	}
+
+	for _, t := range times {
+		modes := map[string]float64{
+			"user":    t.User,
+			"system":  t.System,
+			"idle":    t.Idle,
+			"nice":    t.Nice,
+			"iowait":  t.Iowait,
+			"irq":     t.Irq,
+			"softirq": t.Softirq,
+			"steal":   t.Steal,
+		}
+		for mode, value := range modes {
+			ch <- prometheus.MustNewConstMetric(c.secondsTotal, prometheus.CounterValue, value, c.nodeID, t.CPU, mode)
+		}
+	}
+}
@@ -0,0 +1,80 @@
+package collector
+
+import (
+	"log/slog"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/shirou/gopsutil/v3/disk"
+)
+
+func init() {
+	registerCollector("filesystem", true, newFilesystemCollector)
+}
+
+// FilesystemCollector collects mounted filesystem usage.
+type FilesystemCollector struct {
+	logger *slog.Logger
+	nodeID string
+
+	total   *prometheus.Desc
+	used    *prometheus.Desc
+	percent *prometheus.Desc
+}
+
+func newFilesystemCollector(nodeID string, logger *slog.Logger) Collector {
+	return &FilesystemCollector{
+		logger: logger,
+		nodeID: nodeID,
+
+		total: prometheus.NewDesc(
+			"sentinel_node_filesystem_bytes_total",
+			"Total filesystem space in bytes",
+			[]string{"node", "device", "mountpoint"}, nil,
+		),
+		used: prometheus.NewDesc(
+			"sentinel_node_filesystem_bytes_used",
+			"Used filesystem space in bytes",
+			[]string{"node", "device", "mountpoint"}, nil,
+		),
+		percent: prometheus.NewDesc(
+			"sentinel_node_filesystem_percent",
+			"Filesystem usage percentage",
+			[]string{"node", "device", "mountpoint"}, nil,
+		),
+	}
+}
+
+// Name returns the collector name
+func (c *FilesystemCollector) Name() string {
+	return "filesystem"
+}
+
+// Describe implements prometheus.Collector
+func (c *FilesystemCollector) Describe(ch chan<- *prometheus.Desc) {
+	ch <- c.total
+	ch <- c.used
+	ch <- c.percent
+}
+
+// CollectMetrics implements prometheus.Collector
+func (c *FilesystemCollector) CollectMetrics(ch chan<- prometheus.Metric) {
+	partitions, err := disk.Partitions(false)
+	if err != nil {
+		c.logger.Warn("failed to list filesystem partitions", "error", err)
+		return
+	}
+
+	for _, p := range partitions {
+		usage, err := disk.Usage(p.Mountpoint)
+		if err != nil {
+			c.logger.Warn("failed to collect filesystem usage",
+				"mountpoint", p.Mountpoint,
+				"error", err)
+			continue
+		}
+
+		ch <- prometheus.MustNewConstMetric(c.total, prometheus.GaugeValue, float64(usage.Total), c.nodeID, p.Device, p.Mountpoint)
+		ch <- prometheus.MustNewConstMetric(c.used, prometheus.GaugeValue, float64(usage.Used), c.nodeID, p.Device, p.Mountpoint)
+		ch <- prometheus.MustNewConstMetric(c.percent, prometheus.GaugeValue, usage.UsedPercent, c.nodeID, p.Device, p.Mountpoint)
+	}
+}
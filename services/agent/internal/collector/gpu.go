@@ -1,73 +1,58 @@
 package collector
 
 import (
-	"context"
 	"fmt"
+	"log/slog"
+	"os"
+	"strings"
 
 	"github.com/NVIDIA/go-nvml/pkg/nvml"
 	"github.com/prometheus/client_golang/prometheus"
-	"go.uber.org/zap"
 )
 
+// maxGPUProcesses caps the number of per-process GPU memory series reported
+// per device, to avoid label explosion on busy shared GPUs.
+const maxGPUProcesses = 32
+
+// noMIG is the "mig" label value used for metrics from a physical GPU that
+// has MIG disabled or that aren't scoped to a MIG instance.
+const noMIG = "none"
+
 // GPUCollector collects GPU metrics using NVIDIA NVML
 type GPUCollector struct {
-	logger  *zap.Logger
+	logger  *slog.Logger
 	nodeID  string
 	enabled bool
 
-	// GPU metrics
-	gpuUtilization   *prometheus.GaugeVec
-	gpuMemoryUsed    *prometheus.GaugeVec
-	gpuMemoryTotal   *prometheus.GaugeVec
-	gpuMemoryPercent *prometheus.GaugeVec
-	gpuTemperature   *prometheus.GaugeVec
-	gpuPowerUsage    *prometheus.GaugeVec
-	gpuFanSpeed      *prometheus.GaugeVec
+	gpuTemperature *prometheus.GaugeVec
+	gpuPowerUsage  *prometheus.GaugeVec
+	gpuFanSpeed    *prometheus.GaugeVec
+
+	// Utilization/memory/SM-count metrics are labeled by MIG instance UUID
+	// (or "none" when MIG is disabled). MIG UUIDs churn whenever an operator
+	// reconfigures MIG geometry, so — like NVLink and per-process metrics
+	// below — these are read fresh every scrape and emitted directly as
+	// const metrics rather than cached in a GaugeVec, which would otherwise
+	// pin every old UUID's last-known value in /metrics forever.
+	gpuUtilizationDesc   *prometheus.Desc
+	gpuMemoryUsedDesc    *prometheus.Desc
+	gpuMemoryTotalDesc   *prometheus.Desc
+	gpuMemoryPercentDesc *prometheus.Desc
+	gpuSMCountDesc       *prometheus.Desc
+
+	// NVLink and per-process metrics are read fresh every scrape and emitted
+	// directly as const metrics rather than cached in a GaugeVec.
+	gpuNVLinkBytesTotal  *prometheus.Desc
+	gpuProcessMemoryUsed *prometheus.Desc
 }
 
 // NewGPUCollector creates a new GPU metrics collector
-func NewGPUCollector(nodeID string, enabled bool, logger *zap.Logger) *GPUCollector {
+func NewGPUCollector(nodeID string, enabled bool, logger *slog.Logger) *GPUCollector {
 	return &GPUCollector{
 		logger:  logger,
 		nodeID:  nodeID,
 		enabled: enabled,
 
-		gpuUtilization: prometheus.NewGaugeVec(
-			prometheus.GaugeOpts{
-				Namespace: "sentinel_node",
-				Name:      "gpu_utilization_percent",
-				Help:      "GPU utilization percentage",
-			},
-			[]string{"node", "gpu", "sku"},
-		),
-
-		gpuMemoryUsed: prometheus.NewGaugeVec(
-			prometheus.GaugeOpts{
-				Namespace: "sentinel_node",
-				Name:      "gpu_memory_bytes_used",
-				Help:      "GPU memory used in bytes",
-			},
-			[]string{"node", "gpu", "sku"},
-		),
-
-		gpuMemoryTotal: prometheus.NewGaugeVec(
-			prometheus.GaugeOpts{
-				Namespace: "sentinel_node",
-				Name:      "gpu_memory_bytes_total",
-				Help:      "GPU memory total in bytes",
-			},
-			[]string{"node", "gpu", "sku"},
-		),
-
-		gpuMemoryPercent: prometheus.NewGaugeVec(
-			prometheus.GaugeOpts{
-				Namespace: "sentinel_node",
-				Name:      "gpu_memory_percent",
-				Help:      "GPU memory usage percentage",
-			},
-			[]string{"node", "gpu", "sku"},
-		),
-
 		gpuTemperature: prometheus.NewGaugeVec(
 			prometheus.GaugeOpts{
 				Namespace: "sentinel_node",
@@ -94,6 +79,48 @@ func NewGPUCollector(nodeID string, enabled bool, logger *zap.Logger) *GPUCollec
 			},
 			[]string{"node", "gpu", "sku"},
 		),
+
+		gpuUtilizationDesc: prometheus.NewDesc(
+			"sentinel_node_gpu_utilization_percent",
+			"GPU utilization percentage",
+			[]string{"node", "gpu", "mig", "sku"}, nil,
+		),
+
+		gpuMemoryUsedDesc: prometheus.NewDesc(
+			"sentinel_node_gpu_memory_bytes_used",
+			"GPU memory used in bytes",
+			[]string{"node", "gpu", "mig", "sku"}, nil,
+		),
+
+		gpuMemoryTotalDesc: prometheus.NewDesc(
+			"sentinel_node_gpu_memory_bytes_total",
+			"GPU memory total in bytes",
+			[]string{"node", "gpu", "mig", "sku"}, nil,
+		),
+
+		gpuMemoryPercentDesc: prometheus.NewDesc(
+			"sentinel_node_gpu_memory_percent",
+			"GPU memory usage percentage",
+			[]string{"node", "gpu", "mig", "sku"}, nil,
+		),
+
+		gpuSMCountDesc: prometheus.NewDesc(
+			"sentinel_node_gpu_sm_count",
+			"Number of streaming multiprocessors assigned to the GPU or MIG instance",
+			[]string{"node", "gpu", "mig", "sku"}, nil,
+		),
+
+		gpuNVLinkBytesTotal: prometheus.NewDesc(
+			"sentinel_node_gpu_nvlink_bytes_total",
+			"Cumulative NVLink bytes transferred, by link",
+			[]string{"node", "gpu", "link"}, nil,
+		),
+
+		gpuProcessMemoryUsed: prometheus.NewDesc(
+			"sentinel_node_gpu_process_memory_bytes_used",
+			"GPU memory used by a process running on the device",
+			[]string{"node", "gpu", "pid", "process"}, nil,
+		),
 	}
 }
 
@@ -102,45 +129,47 @@ func (c *GPUCollector) Name() string {
 	return "gpu"
 }
 
-// Collect gathers GPU metrics
-func (c *GPUCollector) Collect(_ context.Context) error {
+// refresh re-reads NVML state into the temperature, power and fan gauge
+// vectors and emits utilization, memory, SM-count, NVLink and per-process
+// metrics directly to ch. It runs on every Prometheus scrape rather than on a
+// background ticker.
+func (c *GPUCollector) refresh(ch chan<- prometheus.Metric) {
 	if !c.enabled {
-		return nil
+		return
 	}
 
 	// Initialize NVML
 	ret := nvml.Init()
 	if ret != nvml.SUCCESS {
 		c.logger.Debug("NVML not available, skipping GPU metrics",
-			zap.String("error", nvml.ErrorString(ret)))
-		return nil
+			"error", nvml.ErrorString(ret))
+		return
 	}
 	defer func() {
 		if ret := nvml.Shutdown(); ret != nvml.SUCCESS {
 			c.logger.Warn("failed to shutdown NVML",
-				zap.String("error", nvml.ErrorString(ret)))
+				"error", nvml.ErrorString(ret))
 		}
 	}()
 
 	// Get device count
 	count, ret := nvml.DeviceGetCount()
 	if ret != nvml.SUCCESS {
-		return fmt.Errorf("failed to get device count: %s", nvml.ErrorString(ret))
+		c.logger.Warn("failed to get device count", "error", nvml.ErrorString(ret))
+		return
 	}
 
 	// Collect metrics for each GPU
 	for i := 0; i < count; i++ {
-		if err := c.collectDeviceMetrics(i); err != nil {
+		if err := c.collectDeviceMetrics(i, ch); err != nil {
 			c.logger.Warn("failed to collect GPU metrics",
-				zap.Int("gpu", i),
-				zap.Error(err))
+				"gpu", i,
+				"error", err)
 		}
 	}
-
-	return nil
 }
 
-func (c *GPUCollector) collectDeviceMetrics(index int) error {
+func (c *GPUCollector) collectDeviceMetrics(index int, ch chan<- prometheus.Metric) error {
 	device, ret := nvml.DeviceGetHandleByIndex(index)
 	if ret != nvml.SUCCESS {
 		return fmt.Errorf("failed to get device handle: %s", nvml.ErrorString(ret))
@@ -154,20 +183,8 @@ func (c *GPUCollector) collectDeviceMetrics(index int) error {
 		name = "unknown"
 	}
 
-	// GPU Utilization
-	utilization, ret := device.GetUtilizationRates()
-	if ret == nvml.SUCCESS {
-		c.gpuUtilization.WithLabelValues(c.nodeID, gpuID, name).Set(float64(utilization.Gpu))
-	}
-
-	// Memory Info
-	memInfo, ret := device.GetMemoryInfo()
-	if ret == nvml.SUCCESS {
-		c.gpuMemoryUsed.WithLabelValues(c.nodeID, gpuID, name).Set(float64(memInfo.Used))
-		c.gpuMemoryTotal.WithLabelValues(c.nodeID, gpuID, name).Set(float64(memInfo.Total))
-		memPercent := (float64(memInfo.Used) / float64(memInfo.Total)) * 100
-		c.gpuMemoryPercent.WithLabelValues(c.nodeID, gpuID, name).Set(memPercent)
-	}
+	c.collectUtilizationAndMemory(device, gpuID, noMIG, name, ch)
+	c.collectMIGInstances(device, gpuID, name, ch)
 
 	// Temperature
 	temp, ret := device.GetTemperature(nvml.TEMPERATURE_GPU)
@@ -188,21 +205,135 @@ func (c *GPUCollector) collectDeviceMetrics(index int) error {
 		c.gpuFanSpeed.WithLabelValues(c.nodeID, gpuID, name).Set(float64(fanSpeed))
 	}
 
+	c.collectNVLink(device, gpuID, ch)
+	c.collectProcesses(device, gpuID, ch)
+
 	return nil
 }
 
+// collectUtilizationAndMemory emits the utilization/memory/SM-count metrics
+// for a physical GPU or a single MIG instance within it, labeled by mig
+// (either noMIG or the MIG instance UUID).
+func (c *GPUCollector) collectUtilizationAndMemory(device nvml.Device, gpuID, mig, sku string, ch chan<- prometheus.Metric) {
+	utilization, ret := device.GetUtilizationRates()
+	if ret == nvml.SUCCESS {
+		ch <- prometheus.MustNewConstMetric(c.gpuUtilizationDesc, prometheus.GaugeValue, float64(utilization.Gpu), c.nodeID, gpuID, mig, sku)
+	}
+
+	memInfo, ret := device.GetMemoryInfo()
+	if ret == nvml.SUCCESS {
+		ch <- prometheus.MustNewConstMetric(c.gpuMemoryUsedDesc, prometheus.GaugeValue, float64(memInfo.Used), c.nodeID, gpuID, mig, sku)
+		ch <- prometheus.MustNewConstMetric(c.gpuMemoryTotalDesc, prometheus.GaugeValue, float64(memInfo.Total), c.nodeID, gpuID, mig, sku)
+		memPercent := (float64(memInfo.Used) / float64(memInfo.Total)) * 100
+		ch <- prometheus.MustNewConstMetric(c.gpuMemoryPercentDesc, prometheus.GaugeValue, memPercent, c.nodeID, gpuID, mig, sku)
+	}
+
+	attrs, ret := device.GetAttributes()
+	if ret == nvml.SUCCESS {
+		ch <- prometheus.MustNewConstMetric(c.gpuSMCountDesc, prometheus.GaugeValue, float64(attrs.MultiprocessorCount), c.nodeID, gpuID, mig, sku)
+	}
+}
+
+// collectMIGInstances reports per-MIG-instance utilization, memory, and SM
+// counts when MIG is enabled on device, using the instance UUID as the "mig"
+// sub-ID.
+func (c *GPUCollector) collectMIGInstances(device nvml.Device, gpuID, sku string, ch chan<- prometheus.Metric) {
+	migMode, _, ret := device.GetMigMode()
+	if ret != nvml.SUCCESS || migMode != nvml.DEVICE_MIG_ENABLE {
+		return
+	}
+
+	maxMigDevices, ret := device.GetMaxMigDeviceCount()
+	if ret != nvml.SUCCESS {
+		c.logger.Warn("failed to get max MIG device count", "gpu", gpuID, "error", nvml.ErrorString(ret))
+		return
+	}
+
+	for i := 0; i < maxMigDevices; i++ {
+		migDevice, ret := device.GetMigDeviceHandleByIndex(i)
+		if ret != nvml.SUCCESS {
+			continue
+		}
+
+		migUUID, ret := migDevice.GetUUID()
+		if ret != nvml.SUCCESS {
+			migUUID = fmt.Sprintf("mig%d", i)
+		}
+
+		c.collectUtilizationAndMemory(migDevice, gpuID, migUUID, sku, ch)
+	}
+}
+
+// collectNVLink emits cumulative NVLink byte counters per link for device.
+func (c *GPUCollector) collectNVLink(device nvml.Device, gpuID string, ch chan<- prometheus.Metric) {
+	for link := 0; link < nvml.NVLINK_MAX_LINKS; link++ {
+		state, ret := device.GetNvLinkState(link)
+		if ret != nvml.SUCCESS || state != nvml.FEATURE_ENABLED {
+			continue
+		}
+
+		rx, tx, ret := device.GetNvLinkUtilizationCounter(link, 0)
+		if ret == nvml.SUCCESS {
+			rxLabel := fmt.Sprintf("link%d_rx", link)
+			ch <- prometheus.MustNewConstMetric(c.gpuNVLinkBytesTotal, prometheus.CounterValue, float64(rx), c.nodeID, gpuID, rxLabel)
+
+			txLabel := fmt.Sprintf("link%d_tx", link)
+			ch <- prometheus.MustNewConstMetric(c.gpuNVLinkBytesTotal, prometheus.CounterValue, float64(tx), c.nodeID, gpuID, txLabel)
+		}
+	}
+}
+
+// collectProcesses emits per-process GPU memory usage for device, capped at
+// maxGPUProcesses to bound label cardinality on heavily shared GPUs.
+func (c *GPUCollector) collectProcesses(device nvml.Device, gpuID string, ch chan<- prometheus.Metric) {
+	processes, ret := device.GetComputeRunningProcesses()
+	if ret != nvml.SUCCESS {
+		return
+	}
+
+	if len(processes) > maxGPUProcesses {
+		c.logger.Warn("truncating GPU process list",
+			"gpu", gpuID,
+			"processes", len(processes),
+			"max", maxGPUProcesses)
+		processes = processes[:maxGPUProcesses]
+	}
+
+	for _, proc := range processes {
+		name := processName(proc.Pid)
+		ch <- prometheus.MustNewConstMetric(
+			c.gpuProcessMemoryUsed, prometheus.GaugeValue, float64(proc.UsedGpuMemory),
+			c.nodeID, gpuID, fmt.Sprintf("%d", proc.Pid), name,
+		)
+	}
+}
+
+// processName resolves a PID to its command name via /proc, falling back to
+// "unknown" when that isn't available (e.g. non-Linux, or the process has
+// already exited).
+func processName(pid uint32) string {
+	data, err := os.ReadFile(fmt.Sprintf("/proc/%d/comm", pid))
+	if err != nil {
+		return "unknown"
+	}
+	return strings.TrimSpace(string(data))
+}
+
 // Describe implements prometheus.Collector
 func (c *GPUCollector) Describe(ch chan<- *prometheus.Desc) {
 	if !c.enabled {
 		return
 	}
-	c.gpuUtilization.Describe(ch)
-	c.gpuMemoryUsed.Describe(ch)
-	c.gpuMemoryTotal.Describe(ch)
-	c.gpuMemoryPercent.Describe(ch)
 	c.gpuTemperature.Describe(ch)
 	c.gpuPowerUsage.Describe(ch)
 	c.gpuFanSpeed.Describe(ch)
+	ch <- c.gpuUtilizationDesc
+	ch <- c.gpuMemoryUsedDesc
+	ch <- c.gpuMemoryTotalDesc
+	ch <- c.gpuMemoryPercentDesc
+	ch <- c.gpuSMCountDesc
+	ch <- c.gpuNVLinkBytesTotal
+	ch <- c.gpuProcessMemoryUsed
 }
 
 // CollectMetrics implements prometheus.Collector
@@ -210,10 +341,7 @@ func (c *GPUCollector) CollectMetrics(ch chan<- prometheus.Metric) {
 	if !c.enabled {
 		return
 	}
-	c.gpuUtilization.Collect(ch)
-	c.gpuMemoryUsed.Collect(ch)
-	c.gpuMemoryTotal.Collect(ch)
-	c.gpuMemoryPercent.Collect(ch)
+	c.refresh(ch)
 	c.gpuTemperature.Collect(ch)
 	c.gpuPowerUsage.Collect(ch)
 	c.gpuFanSpeed.Collect(ch)
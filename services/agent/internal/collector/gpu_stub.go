@@ -4,21 +4,20 @@
 package collector
 
 import (
-	"context"
+	"log/slog"
 
 	"github.com/prometheus/client_golang/prometheus"
-	"go.uber.org/zap"
 )
 
 // GPUCollector is a stub when CGO is disabled
 type GPUCollector struct {
-	logger  *zap.Logger
+	logger  *slog.Logger
 	nodeID  string
 	enabled bool
 }
 
 // NewGPUCollector creates a stub GPU collector
-func NewGPUCollector(nodeID string, enabled bool, logger *zap.Logger) *GPUCollector {
+func NewGPUCollector(nodeID string, enabled bool, logger *slog.Logger) *GPUCollector {
 	if enabled {
 		logger.Warn("GPU metrics disabled: built without CGO support")
 	}
@@ -34,11 +33,6 @@ func (c *GPUCollector) Name() string {
 	return "gpu"
 }
 
-// Collect is a no-op when CGO is disabled
-func (c *GPUCollector) Collect(_ context.Context) error {
-	return nil
-}
-
 // Describe is a no-op when CGO is disabled
 func (c *GPUCollector) Describe(_ chan<- *prometheus.Desc) {
 }
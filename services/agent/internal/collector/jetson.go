@@ -0,0 +1,214 @@
+package collector
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"log/slog"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"regexp"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+// Paths used to auto-detect an NVIDIA Jetson/Tegra SoC, which lacks full
+// NVML support.
+const (
+	tegraReleasePath  = "/etc/nv_tegra_release"
+	jetsonGPULoadPath = "/sys/devices/gpu.0/load"
+	thermalZoneGlob   = "/sys/devices/virtual/thermal/thermal_zone*/temp"
+)
+
+// IsJetson reports whether this host looks like a Jetson/Tegra SoC.
+func IsJetson() bool {
+	if _, err := os.Stat(tegraReleasePath); err == nil {
+		return true
+	}
+	if _, err := os.Stat(jetsonGPULoadPath); err == nil {
+		return true
+	}
+	return false
+}
+
+// JetsonCollector collects GPU/CPU/thermal metrics on Jetson devices by
+// parsing `tegrastats` output and reading sysfs thermal zones, used in place
+// of GPUCollector when NVML is unavailable.
+type JetsonCollector struct {
+	logger *slog.Logger
+	nodeID string
+
+	mu                  sync.RWMutex
+	gpuLoadPercent      float64
+	gr3dFreqMHz         float64
+	emcBandwidthPercent float64
+	railPowerW          map[string]float64
+
+	gpuLoadDesc      *prometheus.Desc
+	gr3dFreqDesc     *prometheus.Desc
+	emcBandwidthDesc *prometheus.Desc
+	railPowerDesc    *prometheus.Desc
+	thermalZoneDesc  *prometheus.Desc
+}
+
+// NewJetsonCollector creates a new Jetson metrics collector.
+func NewJetsonCollector(nodeID string, logger *slog.Logger) *JetsonCollector {
+	return &JetsonCollector{
+		logger:     logger,
+		nodeID:     nodeID,
+		railPowerW: make(map[string]float64),
+
+		gpuLoadDesc: prometheus.NewDesc(
+			"sentinel_node_gpu_jetson_load_percent",
+			"Jetson GR3D (GPU) load percentage",
+			[]string{"node"}, nil,
+		),
+		gr3dFreqDesc: prometheus.NewDesc(
+			"sentinel_node_gpu_jetson_gr3d_freq_mhz",
+			"Jetson GR3D clock frequency in MHz",
+			[]string{"node"}, nil,
+		),
+		emcBandwidthDesc: prometheus.NewDesc(
+			"sentinel_node_gpu_jetson_emc_bandwidth_percent",
+			"Jetson EMC (memory controller) bandwidth utilization percentage",
+			[]string{"node"}, nil,
+		),
+		railPowerDesc: prometheus.NewDesc(
+			"sentinel_node_gpu_jetson_rail_power_watts",
+			"Jetson per-rail power draw in watts (e.g. VDD_IN, VDD_CPU_GPU_CV)",
+			[]string{"node", "rail"}, nil,
+		),
+		thermalZoneDesc: prometheus.NewDesc(
+			"sentinel_node_gpu_jetson_thermal_zone_celsius",
+			"Jetson thermal zone temperature in Celsius",
+			[]string{"node", "zone"}, nil,
+		),
+	}
+}
+
+// Name returns the collector name. It reuses "gpu" since JetsonCollector and
+// GPUCollector are mutually exclusive alternatives for the same role.
+func (c *JetsonCollector) Name() string {
+	return "gpu"
+}
+
+// Start spawns `tegrastats` and parses its line-oriented output in the
+// background until ctx is canceled.
+func (c *JetsonCollector) Start(ctx context.Context) error {
+	cmd := exec.CommandContext(ctx, "tegrastats", "--interval", "1000")
+
+	stdout, err := cmd.StdoutPipe()
+	if err != nil {
+		return fmt.Errorf("failed to open tegrastats stdout: %w", err)
+	}
+
+	if err := cmd.Start(); err != nil {
+		return fmt.Errorf("failed to start tegrastats: %w", err)
+	}
+
+	go func() {
+		scanner := bufio.NewScanner(stdout)
+		for scanner.Scan() {
+			c.parseLine(scanner.Text())
+		}
+		if err := scanner.Err(); err != nil {
+			c.logger.Warn("tegrastats scan error", "error", err)
+		}
+	}()
+
+	go func() {
+		<-ctx.Done()
+		_ = cmd.Wait()
+	}()
+
+	return nil
+}
+
+var (
+	gr3dFreqPattern = regexp.MustCompile(`GR3D_FREQ (\d+)%(?:@(\d+))?`)
+	emcFreqPattern  = regexp.MustCompile(`EMC_FREQ (\d+)%`)
+	railPattern     = regexp.MustCompile(`(VDD_\w+) (\d+)mW/\d+mW`)
+)
+
+// parseLine updates the collector's gauges from a single line of tegrastats
+// output, e.g.:
+//
+//	RAM 2512/7854MB ... EMC_FREQ 12% GR3D_FREQ 34%@921 VDD_IN 3832mW/3832mW VDD_CPU_GPU_CV 768mW/768mW
+func (c *JetsonCollector) parseLine(line string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if m := gr3dFreqPattern.FindStringSubmatch(line); m != nil {
+		if load, err := strconv.ParseFloat(m[1], 64); err == nil {
+			c.gpuLoadPercent = load
+		}
+		if m[2] != "" {
+			if freq, err := strconv.ParseFloat(m[2], 64); err == nil {
+				c.gr3dFreqMHz = freq
+			}
+		}
+	}
+
+	if m := emcFreqPattern.FindStringSubmatch(line); m != nil {
+		if bw, err := strconv.ParseFloat(m[1], 64); err == nil {
+			c.emcBandwidthPercent = bw
+		}
+	}
+
+	for _, m := range railPattern.FindAllStringSubmatch(line, -1) {
+		if mw, err := strconv.ParseFloat(m[2], 64); err == nil {
+			// Convert milliwatts to watts to follow Prometheus base-unit convention.
+			c.railPowerW[m[1]] = mw / 1000.0
+		}
+	}
+}
+
+// Describe implements prometheus.Collector
+func (c *JetsonCollector) Describe(ch chan<- *prometheus.Desc) {
+	ch <- c.gpuLoadDesc
+	ch <- c.gr3dFreqDesc
+	ch <- c.emcBandwidthDesc
+	ch <- c.railPowerDesc
+	ch <- c.thermalZoneDesc
+}
+
+// CollectMetrics implements prometheus.Collector
+func (c *JetsonCollector) CollectMetrics(ch chan<- prometheus.Metric) {
+	c.mu.RLock()
+	ch <- prometheus.MustNewConstMetric(c.gpuLoadDesc, prometheus.GaugeValue, c.gpuLoadPercent, c.nodeID)
+	ch <- prometheus.MustNewConstMetric(c.gr3dFreqDesc, prometheus.GaugeValue, c.gr3dFreqMHz, c.nodeID)
+	ch <- prometheus.MustNewConstMetric(c.emcBandwidthDesc, prometheus.GaugeValue, c.emcBandwidthPercent, c.nodeID)
+	for rail, w := range c.railPowerW {
+		ch <- prometheus.MustNewConstMetric(c.railPowerDesc, prometheus.GaugeValue, w, c.nodeID, rail)
+	}
+	c.mu.RUnlock()
+
+	c.collectThermalZones(ch)
+}
+
+func (c *JetsonCollector) collectThermalZones(ch chan<- prometheus.Metric) {
+	zones, err := filepath.Glob(thermalZoneGlob)
+	if err != nil {
+		c.logger.Warn("failed to glob thermal zones", "error", err)
+		return
+	}
+
+	for _, zonePath := range zones {
+		data, err := os.ReadFile(zonePath)
+		if err != nil {
+			continue
+		}
+
+		milliCelsius, err := strconv.ParseFloat(strings.TrimSpace(string(data)), 64)
+		if err != nil {
+			continue
+		}
+
+		zone := filepath.Base(filepath.Dir(zonePath))
+		ch <- prometheus.MustNewConstMetric(c.thermalZoneDesc, prometheus.GaugeValue, milliCelsius/1000.0, c.nodeID, zone)
+	}
+}
@@ -0,0 +1,78 @@
+package collector
+
+import (
+	"log/slog"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/shirou/gopsutil/v3/mem"
+)
+
+func init() {
+	registerCollector("memory", true, newMemoryCollector)
+}
+
+// MemoryCollector collects virtual memory metrics.
+type MemoryCollector struct {
+	logger *slog.Logger
+	nodeID string
+
+	total     *prometheus.Desc
+	available *prometheus.Desc
+	used      *prometheus.Desc
+	percent   *prometheus.Desc
+}
+
+func newMemoryCollector(nodeID string, logger *slog.Logger) Collector {
+	return &MemoryCollector{
+		logger: logger,
+		nodeID: nodeID,
+
+		total: prometheus.NewDesc(
+			"sentinel_node_memory_bytes_total",
+			"Total memory in bytes",
+			nil, nil,
+		),
+		available: prometheus.NewDesc(
+			"sentinel_node_memory_bytes_available",
+			"Available memory in bytes",
+			nil, nil,
+		),
+		used: prometheus.NewDesc(
+			"sentinel_node_memory_bytes_used",
+			"Used memory in bytes",
+			nil, nil,
+		),
+		percent: prometheus.NewDesc(
+			"sentinel_node_memory_percent",
+			"Memory usage percentage",
+			nil, nil,
+		),
+	}
+}
+
+// Name returns the collector name
+func (c *MemoryCollector) Name() string {
+	return "memory"
+}
+
+// Describe implements prometheus.Collector
+func (c *MemoryCollector) Describe(ch chan<- *prometheus.Desc) {
+	ch <- c.total
+	ch <- c.available
+	ch <- c.used
+	ch <- c.percent
+}
+
+// CollectMetrics implements prometheus.Collector
+func (c *MemoryCollector) CollectMetrics(ch chan<- prometheus.Metric) {
+	vm, err := mem.VirtualMemory()
+	if err != nil {
+		c.logger.Warn("failed to collect memory metrics", "error", err)
+		return
+	}
+
+	ch <- prometheus.MustNewConstMetric(c.total, prometheus.GaugeValue, float64(vm.Total))
+	ch <- prometheus.MustNewConstMetric(c.available, prometheus.GaugeValue, float64(vm.Available))
+	ch <- prometheus.MustNewConstMetric(c.used, prometheus.GaugeValue, float64(vm.Used))
+	ch <- prometheus.MustNewConstMetric(c.percent, prometheus.GaugeValue, vm.UsedPercent)
+}
@@ -0,0 +1,64 @@
+package collector
+
+import (
+	"log/slog"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/shirou/gopsutil/v3/net"
+)
+
+func init() {
+	registerCollector("netdev", true, newNetDevCollector)
+}
+
+// NetDevCollector collects per-interface network counters.
+type NetDevCollector struct {
+	logger *slog.Logger
+	nodeID string
+
+	bytesRecvTotal *prometheus.Desc
+	bytesSentTotal *prometheus.Desc
+}
+
+func newNetDevCollector(nodeID string, logger *slog.Logger) Collector {
+	return &NetDevCollector{
+		logger: logger,
+		nodeID: nodeID,
+
+		bytesRecvTotal: prometheus.NewDesc(
+			"sentinel_node_network_bytes_recv_total",
+			"Cumulative network bytes received",
+			[]string{"node", "interface"}, nil,
+		),
+		bytesSentTotal: prometheus.NewDesc(
+			"sentinel_node_network_bytes_sent_total",
+			"Cumulative network bytes sent",
+			[]string{"node", "interface"}, nil,
+		),
+	}
+}
+
+// Name returns the collector name
+func (c *NetDevCollector) Name() string {
+	return "netdev"
+}
+
+// Describe implements prometheus.Collector
+func (c *NetDevCollector) Describe(ch chan<- *prometheus.Desc) {
+	ch <- c.bytesRecvTotal
+	ch <- c.bytesSentTotal
+}
+
+// CollectMetrics implements prometheus.Collector
+func (c *NetDevCollector) CollectMetrics(ch chan<- prometheus.Metric) {
+	counters, err := net.IOCounters(true)
+	if err != nil {
+		c.logger.Warn("failed to collect network metrics", "error", err)
+		return
+	}
+
+	for _, counter := range counters {
+		ch <- prometheus.MustNewConstMetric(c.bytesRecvTotal, prometheus.CounterValue, float64(counter.BytesRecv), c.nodeID, counter.Name)
+		ch <- prometheus.MustNewConstMetric(c.bytesSentTotal, prometheus.CounterValue, float64(counter.BytesSent), c.nodeID, counter.Name)
+	}
+}
@@ -0,0 +1,47 @@
+package collector
+
+import (
+	"fmt"
+	"log/slog"
+	"strings"
+
+	"github.com/sentinel/agent/internal/config"
+)
+
+// NewNodeCollector builds a Manager populated with every registered
+// collector enabled for cfg, following node_exporter's filter-list
+// conventions: cfg.Collectors is an include/exclude list (a bare name
+// force-enables it, a name prefixed with "-" disables it), and cfg.Collector
+// carries collector.<name>.enabled overrides applied on top of that.
+func NewNodeCollector(cfg *config.Config, nodeID string, logger *slog.Logger) (*Manager, error) {
+	enabled := make(map[string]bool, len(factories))
+	for name, f := range factories {
+		enabled[name] = f.defaultEnabled
+	}
+
+	for _, name := range cfg.Collectors {
+		disable := strings.HasPrefix(name, "-")
+		name = strings.TrimPrefix(name, "-")
+		if _, ok := factories[name]; !ok {
+			return nil, fmt.Errorf("unknown collector %q", name)
+		}
+		enabled[name] = !disable
+	}
+
+	for name, c := range cfg.Collector {
+		if _, ok := factories[name]; !ok {
+			return nil, fmt.Errorf("unknown collector %q", name)
+		}
+		enabled[name] = c.Enabled
+	}
+
+	mgr := NewManager(logger)
+	for name, f := range factories {
+		if !enabled[name] {
+			continue
+		}
+		mgr.Register(f.factory(nodeID, logger))
+	}
+
+	return mgr, nil
+}
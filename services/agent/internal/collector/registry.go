@@ -0,0 +1,28 @@
+package collector
+
+import (
+	"fmt"
+	"log/slog"
+)
+
+// Factory builds a Collector for a given node. Collector files register a
+// Factory under a stable name from their init() function, following
+// node_exporter's registerCollector pattern.
+type Factory func(nodeID string, logger *slog.Logger) Collector
+
+type factoryInfo struct {
+	defaultEnabled bool
+	factory        Factory
+}
+
+var factories = make(map[string]factoryInfo)
+
+// registerCollector registers a collector factory under name. It panics on
+// duplicate registration since that can only happen from a programming error
+// at package init time.
+func registerCollector(name string, defaultEnabled bool, factory Factory) {
+	if _, ok := factories[name]; ok {
+		panic(fmt.Sprintf("collector %q already registered", name))
+	}
+	factories[name] = factoryInfo{defaultEnabled: defaultEnabled, factory: factory}
+}
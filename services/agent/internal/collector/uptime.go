@@ -0,0 +1,54 @@
+package collector
+
+import (
+	"log/slog"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/shirou/gopsutil/v3/host"
+)
+
+func init() {
+	registerCollector("uptime", true, newUptimeCollector)
+}
+
+// UptimeCollector collects node uptime.
+type UptimeCollector struct {
+	logger *slog.Logger
+	nodeID string
+
+	uptime *prometheus.Desc
+}
+
+func newUptimeCollector(nodeID string, logger *slog.Logger) Collector {
+	return &UptimeCollector{
+		logger: logger,
+		nodeID: nodeID,
+
+		uptime: prometheus.NewDesc(
+			"sentinel_node_uptime_seconds",
+			"Node uptime in seconds",
+			nil, nil,
+		),
+	}
+}
+
+// Name returns the collector name
+func (c *UptimeCollector) Name() string {
+	return "uptime"
+}
+
+// Describe implements prometheus.Collector
+func (c *UptimeCollector) Describe(ch chan<- *prometheus.Desc) {
+	ch <- c.uptime
+}
+
+// CollectMetrics implements prometheus.Collector
+func (c *UptimeCollector) CollectMetrics(ch chan<- prometheus.Metric) {
+	uptime, err := host.Uptime()
+	if err != nil {
+		c.logger.Warn("failed to collect uptime", "error", err)
+		return
+	}
+
+	ch <- prometheus.MustNewConstMetric(c.uptime, prometheus.GaugeValue, float64(uptime))
+}
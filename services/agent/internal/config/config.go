@@ -2,19 +2,23 @@ package config
 
 import (
 	"fmt"
+	"time"
 
 	"github.com/spf13/viper"
 )
 
 // Config represents the agent configuration
 type Config struct {
-	NodeID      string      `mapstructure:"node_id"`
-	MetricsPort int         `mapstructure:"metrics_port"`
-	ControlAPI  string      `mapstructure:"control_api"`
-	TLS         TLSConfig   `mapstructure:"tls"`
-	Collectors  []string    `mapstructure:"collectors"`
-	Log         LogConfig   `mapstructure:"log"`
-	GPU         GPUConfig   `mapstructure:"gpu"`
+	NodeID      string                     `mapstructure:"node_id"`
+	MetricsPort int                        `mapstructure:"metrics_port"`
+	ControlAPI  string                     `mapstructure:"control_api"`
+	TLS         TLSConfig                  `mapstructure:"tls"`
+	Collectors  []string                   `mapstructure:"collectors"`
+	Collector   map[string]CollectorConfig `mapstructure:"collector"`
+	Log         LogConfig                  `mapstructure:"log"`
+	GPU         GPUConfig                  `mapstructure:"gpu"`
+	Actions     ActionsConfig              `mapstructure:"actions"`
+	Export      ExportConfig               `mapstructure:"export"`
 }
 
 // TLSConfig holds TLS certificate configuration
@@ -34,6 +38,44 @@ type LogConfig struct {
 // GPUConfig holds GPU monitoring configuration
 type GPUConfig struct {
 	Enabled bool `mapstructure:"enabled"`
+
+	// Jetson controls whether the Jetson/Tegra tegrastats-based collector is
+	// used instead of the NVML-based GPUCollector: "auto" picks it when the
+	// host looks like a Jetson device, "force" always uses it, "off" never
+	// does.
+	Jetson string `mapstructure:"jetson"`
+}
+
+// CollectorConfig holds a per-collector enable/disable override, keyed by
+// collector name under the `collector` map (e.g. `collector.cpu.enabled`).
+type CollectorConfig struct {
+	Enabled bool `mapstructure:"enabled"`
+}
+
+// ActionsConfig holds the node-action executor's RBAC-style scope list and
+// run-script allow-list.
+type ActionsConfig struct {
+	Port            int      `mapstructure:"port"`
+	Allowed         []string `mapstructure:"allowed"`
+	ScriptAllowlist []string `mapstructure:"script_allowlist"`
+
+	// SigningKey is the shared HMAC secret the control plane signs action
+	// requests with; the action server rejects any request that doesn't
+	// carry a valid signature under this key.
+	SigningKey string `mapstructure:"signing_key"`
+}
+
+// ExportConfig controls the push-based metrics exporter, used as an
+// alternative (or complement) to the pull-based /metrics scrape endpoint for
+// nodes behind NAT or on an intermittent network.
+type ExportConfig struct {
+	// Mode is "pull" (scrape only, default), "push" (export only), or "both".
+	Mode string `mapstructure:"mode"`
+	// Protocol is "remote_write" (Prometheus remote-write) or "otlp".
+	Protocol  string        `mapstructure:"protocol"`
+	URL       string        `mapstructure:"url"`
+	Interval  time.Duration `mapstructure:"interval"`
+	BatchSize int           `mapstructure:"batch_size"`
 }
 
 // Load loads configuration from file and environment
@@ -43,10 +85,20 @@ func Load(cfgFile string) (*Config, error) {
 	viper.SetDefault("metrics_port", 9100)
 	viper.SetDefault("control_api", "http://localhost:8000")
 	viper.SetDefault("tls.enabled", false)
-	viper.SetDefault("collectors", []string{"cpu", "memory", "disk", "network"})
+	viper.SetDefault("collectors", []string{})
 	viper.SetDefault("log.level", "info")
 	viper.SetDefault("log.format", "json")
 	viper.SetDefault("gpu.enabled", true)
+	viper.SetDefault("gpu.jetson", "auto")
+	viper.SetDefault("actions.port", 9101)
+	viper.SetDefault("actions.allowed", []string{})
+	viper.SetDefault("actions.script_allowlist", []string{})
+	viper.SetDefault("actions.signing_key", "")
+	viper.SetDefault("export.mode", "pull")
+	viper.SetDefault("export.protocol", "remote_write")
+	viper.SetDefault("export.url", "")
+	viper.SetDefault("export.interval", 15*time.Second)
+	viper.SetDefault("export.batch_size", 500)
 
 	// Read from config file if provided
 	if cfgFile != "" {
@@ -0,0 +1,227 @@
+// Package exporter periodically gathers metrics from a Prometheus registry
+// and pushes them to a remote endpoint, as an alternative (or complement) to
+// the pull-based /metrics scrape endpoint for nodes behind NAT or on an
+// intermittent network.
+package exporter
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"os"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	dto "github.com/prometheus/client_model/go"
+
+	"github.com/sentinel/agent/internal/config"
+)
+
+// defaultInterval and defaultBatchSize apply when cfg.Export doesn't set
+// them (e.g. zero-value config in tests or minimal configs).
+const (
+	defaultInterval  = 15 * time.Second
+	defaultBatchSize = 500
+	maxPushAttempts  = 5
+	initialBackoff   = 500 * time.Millisecond
+)
+
+// Exporter periodically gathers metrics from gatherer and pushes them to
+// cfg.URL using cfg.Protocol, batching families and retrying with
+// exponential backoff on 5xx responses.
+type Exporter struct {
+	gatherer prometheus.Gatherer
+	cfg      config.ExportConfig
+	client   *http.Client
+	logger   *slog.Logger
+
+	push func(ctx context.Context, families []*dto.MetricFamily) error
+}
+
+// New creates an Exporter. TLS material is reused from cfg.TLS, the same
+// certificate and CA used by the action server, so the push client
+// authenticates to the control plane with the same identity.
+func New(gatherer prometheus.Gatherer, exportCfg config.ExportConfig, tlsCfg config.TLSConfig, logger *slog.Logger) (*Exporter, error) {
+	client, err := httpClient(tlsCfg)
+	if err != nil {
+		return nil, fmt.Errorf("failed to build exporter HTTP client: %w", err)
+	}
+
+	e := &Exporter{
+		gatherer: gatherer,
+		cfg:      exportCfg,
+		client:   client,
+		logger:   logger,
+	}
+
+	switch exportCfg.Protocol {
+	case "otlp":
+		e.push = e.pushOTLP
+	default:
+		e.push = e.pushRemoteWrite
+	}
+
+	return e, nil
+}
+
+// Enabled reports whether the exporter should run, based on cfg.Export.Mode.
+func (e *Exporter) Enabled() bool {
+	return e.cfg.Mode == "push" || e.cfg.Mode == "both"
+}
+
+// Start runs the export loop until ctx is canceled. It is a no-op unless
+// Enabled returns true.
+func (e *Exporter) Start(ctx context.Context) error {
+	if !e.Enabled() {
+		return nil
+	}
+
+	interval := e.cfg.Interval
+	if interval <= 0 {
+		interval = defaultInterval
+	}
+
+	e.logger.Info("starting metrics exporter",
+		"protocol", e.cfg.Protocol,
+		"url", e.cfg.URL,
+		"interval", interval,
+	)
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+			if err := e.exportOnce(ctx); err != nil {
+				e.logger.Warn("metrics export failed", "error", err)
+			}
+		}
+	}
+}
+
+func (e *Exporter) exportOnce(ctx context.Context) error {
+	families, err := e.gatherer.Gather()
+	if err != nil {
+		return fmt.Errorf("failed to gather metrics: %w", err)
+	}
+
+	batchSize := e.cfg.BatchSize
+	if batchSize <= 0 {
+		batchSize = defaultBatchSize
+	}
+
+	for start := 0; start < len(families); start += batchSize {
+		end := start + batchSize
+		if end > len(families) {
+			end = len(families)
+		}
+		if err := e.pushWithRetry(ctx, families[start:end]); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (e *Exporter) pushWithRetry(ctx context.Context, families []*dto.MetricFamily) error {
+	backoff := initialBackoff
+	var lastErr error
+
+	for attempt := 0; attempt < maxPushAttempts; attempt++ {
+		if attempt > 0 {
+			select {
+			case <-ctx.Done():
+				return ctx.Err()
+			case <-time.After(backoff):
+			}
+			backoff *= 2
+		}
+
+		err := e.push(ctx, families)
+		if err == nil {
+			return nil
+		}
+
+		lastErr = err
+		if !isRetryable(err) {
+			return err
+		}
+		e.logger.Debug("retrying metrics export", "attempt", attempt+1, "error", err)
+	}
+
+	return fmt.Errorf("metrics export failed after %d attempts: %w", maxPushAttempts, lastErr)
+}
+
+// statusError carries an HTTP status code returned by the remote endpoint, so
+// pushWithRetry can decide whether it's worth retrying.
+type statusError struct {
+	code int
+}
+
+func (e *statusError) Error() string {
+	return fmt.Sprintf("remote endpoint returned status %d", e.code)
+}
+
+// isRetryable reports whether err is worth retrying: network errors always
+// are, HTTP errors only when the remote returned a 5xx.
+func isRetryable(err error) bool {
+	var se *statusError
+	if errors.As(err, &se) {
+		return se.code >= http.StatusInternalServerError
+	}
+	return true
+}
+
+// httpClient builds the HTTP client used to push metrics, configuring mTLS
+// from cfg when enabled.
+func httpClient(cfg config.TLSConfig) (*http.Client, error) {
+	client := &http.Client{Timeout: 30 * time.Second}
+	if !cfg.Enabled {
+		return client, nil
+	}
+
+	tlsConfig, err := buildClientTLSConfig(cfg)
+	if err != nil {
+		return nil, err
+	}
+	client.Transport = &http.Transport{TLSClientConfig: tlsConfig}
+
+	return client, nil
+}
+
+// buildClientTLSConfig loads the agent's own certificate (presented for
+// mTLS) and, when a CA is configured, trusts it for verifying the control
+// plane's server certificate.
+func buildClientTLSConfig(cfg config.TLSConfig) (*tls.Config, error) {
+	cert, err := tls.LoadX509KeyPair(cfg.Cert, cfg.Key)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load TLS certificate: %w", err)
+	}
+
+	tlsConfig := &tls.Config{
+		Certificates: []tls.Certificate{cert},
+		MinVersion:   tls.VersionTLS12,
+	}
+
+	if cfg.CA != "" {
+		caCert, err := os.ReadFile(cfg.CA)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read CA certificate: %w", err)
+		}
+
+		pool := x509.NewCertPool()
+		if !pool.AppendCertsFromPEM(caCert) {
+			return nil, fmt.Errorf("failed to parse CA certificate")
+		}
+		tlsConfig.RootCAs = pool
+	}
+
+	return tlsConfig, nil
+}
@@ -0,0 +1,127 @@
+package exporter
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"net/http"
+	"time"
+
+	dto "github.com/prometheus/client_model/go"
+	colmetricspb "go.opentelemetry.io/proto/otlp/collector/metrics/v1"
+	commonpb "go.opentelemetry.io/proto/otlp/common/v1"
+	metricspb "go.opentelemetry.io/proto/otlp/metrics/v1"
+	resourcepb "go.opentelemetry.io/proto/otlp/resource/v1"
+	"google.golang.org/protobuf/proto"
+)
+
+// pushOTLP translates families into an OTLP ExportMetricsServiceRequest and
+// POSTs it to cfg.URL.
+func (e *Exporter) pushOTLP(ctx context.Context, families []*dto.MetricFamily) error {
+	req := &colmetricspb.ExportMetricsServiceRequest{
+		ResourceMetrics: []*metricspb.ResourceMetrics{
+			{
+				Resource: &resourcepb.Resource{
+					Attributes: []*commonpb.KeyValue{
+						{Key: "service.name", Value: &commonpb.AnyValue{Value: &commonpb.AnyValue_StringValue{StringValue: "sentinel-agent"}}},
+					},
+				},
+				ScopeMetrics: []*metricspb.ScopeMetrics{
+					{Metrics: familiesToOTLPMetrics(families)},
+				},
+			},
+		},
+	}
+
+	data, err := proto.Marshal(req)
+	if err != nil {
+		return fmt.Errorf("failed to marshal OTLP request: %w", err)
+	}
+
+	httpReq, err := http.NewRequestWithContext(ctx, http.MethodPost, e.cfg.URL, bytes.NewReader(data))
+	if err != nil {
+		return fmt.Errorf("failed to build OTLP request: %w", err)
+	}
+	httpReq.Header.Set("Content-Type", "application/x-protobuf")
+
+	resp, err := e.client.Do(httpReq)
+	if err != nil {
+		return fmt.Errorf("OTLP request failed: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode/100 != 2 {
+		return &statusError{code: resp.StatusCode}
+	}
+	return nil
+}
+
+// familiesToOTLPMetrics converts families to OTLP metrics, mapping
+// Prometheus counters/gauges onto OTLP's equivalent Sum/Gauge data points.
+func familiesToOTLPMetrics(families []*dto.MetricFamily) []*metricspb.Metric {
+	now := uint64(time.Now().UnixNano())
+
+	metrics := make([]*metricspb.Metric, 0, len(families))
+	for _, family := range families {
+		switch family.GetType() {
+		case dto.MetricType_COUNTER:
+			metrics = append(metrics, &metricspb.Metric{
+				Name: family.GetName(),
+				Help: family.GetHelp(),
+				Data: &metricspb.Metric_Sum{
+					Sum: &metricspb.Sum{
+						AggregationTemporality: metricspb.AggregationTemporality_AGGREGATION_TEMPORALITY_CUMULATIVE,
+						IsMonotonic:            true,
+						DataPoints:             counterDataPoints(family.GetMetric(), now),
+					},
+				},
+			})
+		case dto.MetricType_GAUGE:
+			metrics = append(metrics, &metricspb.Metric{
+				Name: family.GetName(),
+				Help: family.GetHelp(),
+				Data: &metricspb.Metric_Gauge{
+					Gauge: &metricspb.Gauge{
+						DataPoints: gaugeDataPoints(family.GetMetric(), now),
+					},
+				},
+			})
+		}
+	}
+	return metrics
+}
+
+func counterDataPoints(ms []*dto.Metric, ts uint64) []*metricspb.NumberDataPoint {
+	points := make([]*metricspb.NumberDataPoint, 0, len(ms))
+	for _, m := range ms {
+		points = append(points, &metricspb.NumberDataPoint{
+			Attributes:   labelsToAttributes(m.GetLabel()),
+			TimeUnixNano: ts,
+			Value:        &metricspb.NumberDataPoint_AsDouble{AsDouble: m.GetCounter().GetValue()},
+		})
+	}
+	return points
+}
+
+func gaugeDataPoints(ms []*dto.Metric, ts uint64) []*metricspb.NumberDataPoint {
+	points := make([]*metricspb.NumberDataPoint, 0, len(ms))
+	for _, m := range ms {
+		points = append(points, &metricspb.NumberDataPoint{
+			Attributes:   labelsToAttributes(m.GetLabel()),
+			TimeUnixNano: ts,
+			Value:        &metricspb.NumberDataPoint_AsDouble{AsDouble: m.GetGauge().GetValue()},
+		})
+	}
+	return points
+}
+
+func labelsToAttributes(labels []*dto.LabelPair) []*commonpb.KeyValue {
+	attrs := make([]*commonpb.KeyValue, 0, len(labels))
+	for _, lp := range labels {
+		attrs = append(attrs, &commonpb.KeyValue{
+			Key:   lp.GetName(),
+			Value: &commonpb.AnyValue{Value: &commonpb.AnyValue_StringValue{StringValue: lp.GetValue()}},
+		})
+	}
+	return attrs
+}
@@ -0,0 +1,94 @@
+package exporter
+
+import (
+	"testing"
+
+	dto "github.com/prometheus/client_model/go"
+	metricspb "go.opentelemetry.io/proto/otlp/metrics/v1"
+)
+
+func TestFamiliesToOTLPMetricsCounter(t *testing.T) {
+	name := "sentinel_node_action_invoked_total"
+	help := "Total node actions invoked, by type"
+	metricType := dto.MetricType_COUNTER
+	value := 7.0
+
+	families := []*dto.MetricFamily{
+		{
+			Name: &name,
+			Help: &help,
+			Type: &metricType,
+			Metric: []*dto.Metric{
+				{Counter: &dto.Counter{Value: &value}},
+			},
+		},
+	}
+
+	metrics := familiesToOTLPMetrics(families)
+	if len(metrics) != 1 {
+		t.Fatalf("expected 1 metric, got %d", len(metrics))
+	}
+
+	m := metrics[0]
+	if m.Name != name || m.Help != help {
+		t.Fatalf("expected name=%s help=%s, got name=%s help=%s", name, help, m.Name, m.Help)
+	}
+
+	sum, ok := m.Data.(*metricspb.Metric_Sum)
+	if !ok {
+		t.Fatalf("expected a Sum data point, got %T", m.Data)
+	}
+	if !sum.Sum.IsMonotonic {
+		t.Fatal("expected a Prometheus counter to map to a monotonic OTLP sum")
+	}
+	if len(sum.Sum.DataPoints) != 1 || sum.Sum.DataPoints[0].GetAsDouble() != value {
+		t.Fatalf("expected a single data point with value %v, got %+v", value, sum.Sum.DataPoints)
+	}
+}
+
+func TestFamiliesToOTLPMetricsGauge(t *testing.T) {
+	name := "sentinel_node_gpu_power_watts"
+	metricType := dto.MetricType_GAUGE
+	value := 123.4
+
+	families := []*dto.MetricFamily{
+		{
+			Name: &name,
+			Type: &metricType,
+			Metric: []*dto.Metric{
+				{Gauge: &dto.Gauge{Value: &value}},
+			},
+		},
+	}
+
+	metrics := familiesToOTLPMetrics(families)
+	if len(metrics) != 1 {
+		t.Fatalf("expected 1 metric, got %d", len(metrics))
+	}
+
+	gauge, ok := metrics[0].Data.(*metricspb.Metric_Gauge)
+	if !ok {
+		t.Fatalf("expected a Gauge data point, got %T", metrics[0].Data)
+	}
+	if len(gauge.Gauge.DataPoints) != 1 || gauge.Gauge.DataPoints[0].GetAsDouble() != value {
+		t.Fatalf("expected a single data point with value %v, got %+v", value, gauge.Gauge.DataPoints)
+	}
+}
+
+func TestFamiliesToOTLPMetricsSkipsUnsupportedTypes(t *testing.T) {
+	name := "sentinel_node_latency_seconds"
+	metricType := dto.MetricType_SUMMARY
+
+	families := []*dto.MetricFamily{
+		{
+			Name:   &name,
+			Type:   &metricType,
+			Metric: []*dto.Metric{{Summary: &dto.Summary{}}},
+		},
+	}
+
+	metrics := familiesToOTLPMetrics(families)
+	if len(metrics) != 0 {
+		t.Fatalf("expected summaries to be skipped, got %d metrics", len(metrics))
+	}
+}
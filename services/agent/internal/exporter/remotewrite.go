@@ -0,0 +1,97 @@
+package exporter
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/gogo/protobuf/proto"
+	"github.com/golang/snappy"
+	dto "github.com/prometheus/client_model/go"
+	"github.com/prometheus/prometheus/prompb"
+)
+
+// pushRemoteWrite translates families into a Prometheus remote-write
+// WriteRequest and POSTs it, snappy-compressed, to cfg.URL.
+func (e *Exporter) pushRemoteWrite(ctx context.Context, families []*dto.MetricFamily) error {
+	req := &prompb.WriteRequest{
+		Timeseries: familiesToTimeseries(families),
+	}
+
+	data, err := proto.Marshal(req)
+	if err != nil {
+		return fmt.Errorf("failed to marshal remote-write request: %w", err)
+	}
+	compressed := snappy.Encode(nil, data)
+
+	httpReq, err := http.NewRequestWithContext(ctx, http.MethodPost, e.cfg.URL, bytes.NewReader(compressed))
+	if err != nil {
+		return fmt.Errorf("failed to build remote-write request: %w", err)
+	}
+	httpReq.Header.Set("Content-Type", "application/x-protobuf")
+	httpReq.Header.Set("Content-Encoding", "snappy")
+	httpReq.Header.Set("X-Prometheus-Remote-Write-Version", "0.1.0")
+
+	resp, err := e.client.Do(httpReq)
+	if err != nil {
+		return fmt.Errorf("remote-write request failed: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode/100 != 2 {
+		return &statusError{code: resp.StatusCode}
+	}
+	return nil
+}
+
+// familiesToTimeseries flattens metric families into remote-write
+// timeseries, one per label combination, converting each family's samples to
+// their prompb sample type based on the family's metric type.
+func familiesToTimeseries(families []*dto.MetricFamily) []prompb.TimeSeries {
+	now := time.Now().UnixMilli()
+
+	var series []prompb.TimeSeries
+	for _, family := range families {
+		name := family.GetName()
+		for _, m := range family.GetMetric() {
+			value, ok := metricValue(family.GetType(), m)
+			if !ok {
+				continue
+			}
+
+			labels := []prompb.Label{{Name: "__name__", Value: name}}
+			for _, lp := range m.GetLabel() {
+				labels = append(labels, prompb.Label{Name: lp.GetName(), Value: lp.GetValue()})
+			}
+
+			ts := now
+			if m.GetTimestampMs() != 0 {
+				ts = m.GetTimestampMs()
+			}
+
+			series = append(series, prompb.TimeSeries{
+				Labels:  labels,
+				Samples: []prompb.Sample{{Value: value, Timestamp: ts}},
+			})
+		}
+	}
+	return series
+}
+
+// metricValue extracts the single float value remote-write cares about for
+// the given metric type, skipping types (e.g. histograms, summaries) that
+// don't reduce to one.
+func metricValue(mtype dto.MetricType, m *dto.Metric) (float64, bool) {
+	switch mtype {
+	case dto.MetricType_COUNTER:
+		return m.GetCounter().GetValue(), true
+	case dto.MetricType_GAUGE:
+		return m.GetGauge().GetValue(), true
+	case dto.MetricType_UNTYPED:
+		return m.GetUntyped().GetValue(), true
+	default:
+		return 0, false
+	}
+}
@@ -0,0 +1,65 @@
+package exporter
+
+import (
+	"testing"
+
+	dto "github.com/prometheus/client_model/go"
+)
+
+func TestFamiliesToTimeseries(t *testing.T) {
+	name := "sentinel_node_cpu_percent"
+	metricType := dto.MetricType_GAUGE
+	value := 42.5
+	labelName, labelValue := "node", "node-1"
+
+	families := []*dto.MetricFamily{
+		{
+			Name: &name,
+			Type: &metricType,
+			Metric: []*dto.Metric{
+				{
+					Label: []*dto.LabelPair{{Name: &labelName, Value: &labelValue}},
+					Gauge: &dto.Gauge{Value: &value},
+				},
+			},
+		},
+	}
+
+	series := familiesToTimeseries(families)
+	if len(series) != 1 {
+		t.Fatalf("expected 1 timeseries, got %d", len(series))
+	}
+
+	ts := series[0]
+	if len(ts.Samples) != 1 || ts.Samples[0].Value != value {
+		t.Fatalf("expected a single sample with value %v, got %+v", value, ts.Samples)
+	}
+
+	wantLabels := map[string]string{"__name__": name, labelName: labelValue}
+	if len(ts.Labels) != len(wantLabels) {
+		t.Fatalf("expected %d labels, got %d: %+v", len(wantLabels), len(ts.Labels), ts.Labels)
+	}
+	for _, l := range ts.Labels {
+		if want, ok := wantLabels[l.Name]; !ok || want != l.Value {
+			t.Fatalf("unexpected label %s=%s", l.Name, l.Value)
+		}
+	}
+}
+
+func TestFamiliesToTimeseriesSkipsUnsupportedTypes(t *testing.T) {
+	name := "sentinel_node_latency_seconds"
+	metricType := dto.MetricType_HISTOGRAM
+
+	families := []*dto.MetricFamily{
+		{
+			Name:   &name,
+			Type:   &metricType,
+			Metric: []*dto.Metric{{Histogram: &dto.Histogram{}}},
+		},
+	}
+
+	series := familiesToTimeseries(families)
+	if len(series) != 0 {
+		t.Fatalf("expected histograms to be skipped, got %d timeseries", len(series))
+	}
+}
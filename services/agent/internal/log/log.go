@@ -0,0 +1,38 @@
+// Package log builds the agent's structured logger on top of the standard
+// library's log/slog, replacing the previous go.uber.org/zap setup.
+package log
+
+import (
+	"log/slog"
+	"os"
+	"strings"
+)
+
+// NewLogger builds a *slog.Logger honoring level ("debug", "info", "warn", or
+// "error") and format ("json" or "text"), matching the existing
+// cfg.Log.Level/cfg.Log.Format config keys so upgrades are non-breaking.
+func NewLogger(level, format string) *slog.Logger {
+	opts := &slog.HandlerOptions{Level: parseLevel(level)}
+
+	var handler slog.Handler
+	if format == "text" {
+		handler = slog.NewTextHandler(os.Stdout, opts)
+	} else {
+		handler = slog.NewJSONHandler(os.Stdout, opts)
+	}
+
+	return slog.New(handler)
+}
+
+func parseLevel(level string) slog.Level {
+	switch strings.ToLower(level) {
+	case "debug":
+		return slog.LevelDebug
+	case "warn", "warning":
+		return slog.LevelWarn
+	case "error":
+		return slog.LevelError
+	default:
+		return slog.LevelInfo
+	}
+}
@@ -3,24 +3,24 @@ package metrics
 import (
 	"context"
 	"fmt"
+	"log/slog"
 	"net/http"
 	"time"
 
 	"github.com/prometheus/client_golang/prometheus"
 	"github.com/prometheus/client_golang/prometheus/promhttp"
-	"go.uber.org/zap"
 )
 
 // Server is the HTTP server for metrics exposure
 type Server struct {
-	port       int
-	server     *http.Server
-	registry   *prometheus.Registry
-	logger     *zap.Logger
+	port     int
+	server   *http.Server
+	registry *prometheus.Registry
+	logger   *slog.Logger
 }
 
 // NewServer creates a new metrics server
-func NewServer(port int, registry *prometheus.Registry, logger *zap.Logger) *Server {
+func NewServer(port int, registry *prometheus.Registry, logger *slog.Logger) *Server {
 	return &Server{
 		port:     port,
 		registry: registry,
@@ -44,7 +44,7 @@ func (s *Server) Start(ctx context.Context) error {
 	mux.HandleFunc("/health", func(w http.ResponseWriter, _ *http.Request) {
 		w.WriteHeader(http.StatusOK)
 		if _, err := w.Write([]byte(`{"status":"healthy"}`)); err != nil {
-			s.logger.Error("failed to write health response", zap.Error(err))
+			s.logger.Error("failed to write health response", "error", err)
 		}
 	})
 
@@ -52,7 +52,7 @@ func (s *Server) Start(ctx context.Context) error {
 	mux.HandleFunc("/ready", func(w http.ResponseWriter, _ *http.Request) {
 		w.WriteHeader(http.StatusOK)
 		if _, err := w.Write([]byte(`{"status":"ready"}`)); err != nil {
-			s.logger.Error("failed to write ready response", zap.Error(err))
+			s.logger.Error("failed to write ready response", "error", err)
 		}
 	})
 
@@ -64,11 +64,11 @@ func (s *Server) Start(ctx context.Context) error {
 		IdleTimeout:  60 * time.Second,
 	}
 
-	s.logger.Info("starting metrics server", zap.Int("port", s.port))
+	s.logger.Info("starting metrics server", "port", s.port)
 
 	go func() {
 		if err := s.server.ListenAndServe(); err != nil && err != http.ErrServerClosed {
-			s.logger.Error("metrics server error", zap.Error(err))
+			s.logger.Error("metrics server error", "error", err)
 		}
 	}()
 